@@ -0,0 +1,260 @@
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/kerl"
+	"github.com/iotaledger/iota.go/trinary"
+	"github.com/wollac/iota-crypto-demo/pkg/bip32path"
+	"github.com/wollac/iota-crypto-demo/pkg/bip39"
+	"github.com/wollac/iota-crypto-demo/pkg/slip10"
+)
+
+// runDerive implements the "derive" subcommand: it derives the legacy IOTA and Ed25519 keys for
+// an address path from a mnemonic (or freshly generated entropy), mirroring the tool's original,
+// subcommand-less behavior.
+func runDerive(args []string) error {
+	fs := flag.NewFlagSet("derive", flag.ExitOnError)
+	mnemonicString := fs.String(
+		"mnemonic",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"mnemonic sentence according to BIP-39, 12-24 words are supported; if empty a random entropy is generated",
+	)
+	language := fs.String(
+		"language",
+		"",
+		"language of the mnemonic; if empty it is auto-detected from -mnemonic, falling back to english when a fresh mnemonic is generated",
+	)
+	passphrase := fs.String(
+		"passphrase",
+		"",
+		"secret passphrase to generate the master seed; can be empty",
+	)
+	pathString := fs.String(
+		"path",
+		"44'/4218'/0'/0'",
+		"string form of the BIP-32 address path to derive the extended private key",
+	)
+	iterations := fs.Int(
+		"iterations",
+		bip39.StandardSeedIterations,
+		"PBKDF2-HMAC-SHA512 iteration count used to derive the master seed; must be at least the BIP-39 standard of 2048, raise it to harden high-value wallets",
+	)
+	fs.IntVar(iterations, "kdf-rounds", bip39.StandardSeedIterations, "alias for -iterations")
+	coinType := fs.Uint(
+		"coin-type",
+		4218,
+		"BIP-44 coin type used by -scan-accounts/-scan-addresses; 4218 is IOTA's registered coin type",
+	)
+	scanAccounts := fs.Int(
+		"scan-accounts",
+		0,
+		"if greater than 0, additionally scan this many BIP-44 accounts (m/44'/coin-type'/account') on both curves",
+	)
+	scanAddresses := fs.Int(
+		"scan-addresses",
+		1,
+		"number of external (change 0) addresses to derive per scanned account",
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var (
+		err      error
+		entropy  []byte
+		mnemonic bip39.Mnemonic
+	)
+
+	if err := setWordList(*language, *mnemonicString); err != nil {
+		return err
+	}
+	if len(*mnemonicString) == 0 {
+		// no mnemonic given, generate
+		entropy, err = generateEntropy(256 / 8 /* 256 bits */)
+		if err != nil {
+			return fmt.Errorf("failed generating entropy: %w", err)
+		}
+		mnemonic, _ = bip39.EntropyToMnemonic(entropy)
+	} else {
+		mnemonic = bip39.ParseMnemonic(*mnemonicString)
+		entropy, err = bip39.MnemonicToEntropy(mnemonic)
+		if err != nil {
+			return fmt.Errorf("invalid mnemonic: %w", err)
+		}
+	}
+
+	seed, err := bip39.MnemonicToSeedWithIterations(mnemonic, *passphrase, *iterations)
+	if err != nil {
+		return fmt.Errorf("failed deriving master seed: %w", err)
+	}
+	path, err := bip32path.ParsePath(*pathString)
+	if err != nil {
+		return fmt.Errorf("invalid path: %w", err)
+	}
+
+	fmt.Println("==> Key Derivation Parameters")
+
+	fmt.Printf(" entropy (%d-byte):\t%x\n", len(entropy), entropy)
+	fmt.Printf(" mnemonic (%d-word):\t%s\n", len(mnemonic), mnemonic)
+	fmt.Printf(" optional passphrase:\t\"%s\"\n", *passphrase)
+	fmt.Printf(" KDF iterations:\t%d\n", *iterations)
+	fmt.Printf(" master seed (%d-byte):\t%x\n", len(seed), seed)
+
+	fmt.Println("\n==> Legacy IOTA Seed Derivation (Ledger App)")
+
+	curve := slip10.Secp256k1()
+	key, err := slip10.DeriveKeyFromPath(seed, curve, path)
+	if err != nil {
+		return fmt.Errorf("failed deriving %s key: %w", curve.Name(), err)
+	}
+
+	fmt.Printf(" SLIP-10 curve seed:\t%s\n", curve.SeedKey())
+	fmt.Printf(" SLIP-10 address path:\t%s\n", path)
+
+	fmt.Printf(" private key (%d-byte):\t%x\n", slip10.PrivateKeySize, key.Key)
+	fmt.Printf(" chain code (%d-byte):\t%x\n", slip10.ChainCodeSize, key.ChainCode)
+	fmt.Printf(" IOTA seed (%d-tryte):\t%s\n", consts.HashTrytesSize, iotaSeedFromKey(key))
+	printExtendedKeys(seed, key, curve, path)
+
+	fmt.Println("\n==> Ed25519 Private Key Derivation")
+
+	curve = slip10.Ed25519()
+	key, err = slip10.DeriveKeyFromPath(seed, curve, path)
+	if err != nil {
+		return fmt.Errorf("failed deriving %s key: %w", curve.Name(), err)
+	}
+
+	fmt.Printf(" SLIP-10 curve seed:\t%s\n", curve.SeedKey())
+	fmt.Printf(" SLIP-10 address path:\t%s\n", path)
+
+	fmt.Printf(" private key (%d-byte):\t%x\n", slip10.PrivateKeySize, key.Key)
+	fmt.Printf(" chain code (%d-byte):\t%x\n", slip10.ChainCodeSize, key.ChainCode)
+	fmt.Printf(" public key (%d-byte):\t%x\n", slip10.PublicKeySize, curve.PublicKey(key))
+	printExtendedKeys(seed, key, curve, path)
+
+	if *scanAccounts > 0 {
+		fmt.Println("\n==> BIP-44 Account/Address Scan")
+		if err := scanAccountAddresses(seed, uint32(*coinType), *scanAccounts, *scanAddresses); err != nil {
+			return fmt.Errorf("failed scanning accounts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// scanAccountAddresses derives and prints the external addresses of the first numAccounts BIP-44
+// accounts under coinType, on both the secp256k1 and Ed25519 curves.
+func scanAccountAddresses(seed []byte, coinType uint32, numAccounts, numAddresses int) error {
+	for _, curve := range []slip10.Curve{slip10.Secp256k1(), slip10.Ed25519()} {
+		fmt.Printf(" %s:\n", curve.Name())
+		for account := 0; account < numAccounts; account++ {
+			xkey, err := slip10.DeriveAccount(seed, curve, 44, coinType, uint32(account))
+			if err != nil {
+				return fmt.Errorf("account %d: %w", account, err)
+			}
+			changeMarker, indexMarker := "", ""
+			if curve.Name() == slip10.Ed25519().Name() {
+				changeMarker, indexMarker = "'", "'"
+			}
+			for index := 0; index < numAddresses; index++ {
+				key, err := xkey.Address(0, uint32(index))
+				if err != nil {
+					return fmt.Errorf("account %d, address %d: %w", account, index, err)
+				}
+				fmt.Printf("  m/44'/%d'/%d'/0%s/%d%s:\t%x\n", coinType, account, changeMarker, index, indexMarker, curve.PublicKey(key))
+			}
+		}
+	}
+	return nil
+}
+
+// printExtendedKeys prints the BIP-32 extended private and public key (xprv/xpub) for key, or a
+// short explanation when curve does not support extended key serialization. When path is
+// non-empty, key's parent is re-derived from seed to compute the real ParentFingerprint; only the
+// master key (empty path) may use the all-zero fingerprint.
+func printExtendedKeys(seed []byte, key *slip10.Key, curve slip10.Curve, path bip32path.Path) {
+	var parentFingerprint [4]byte
+	var childNumber uint32
+	if len(path) > 0 {
+		childNumber = path[len(path)-1]
+
+		parentKey, err := slip10.DeriveKeyFromPath(seed, curve, path[:len(path)-1])
+		if err != nil {
+			fmt.Printf(" extended keys:\t\tnot available (failed re-deriving parent key: %s)\n", err)
+			return
+		}
+		if parentFingerprint, err = slip10.Fingerprint(curve, parentKey); err != nil {
+			fmt.Printf(" extended keys:\t\tnot available (%s)\n", err)
+			return
+		}
+	}
+	depth := byte(len(path))
+
+	xprv, err := key.SerializePrivate(curve, slip10.VersionMainnetPrivate, depth, parentFingerprint, childNumber)
+	if err != nil {
+		fmt.Printf(" extended keys:\t\tnot available (%s)\n", err)
+		return
+	}
+	xpub, err := key.SerializePublic(curve, slip10.VersionMainnetPublic, depth, parentFingerprint, childNumber)
+	if err != nil {
+		fmt.Printf(" extended keys:\t\tnot available (%s)\n", err)
+		return
+	}
+
+	fmt.Printf(" extended private key:\t%s\n", xprv)
+	fmt.Printf(" extended public key:\t%s\n", xpub)
+}
+
+// setWordList loads the word list named by language, or auto-detects it from mnemonicString when
+// language is empty, falling back to english when no mnemonic is given either.
+func setWordList(language, mnemonicString string) error {
+	switch {
+	case language != "":
+		return bip39.SetWordList(strings.ToLower(language))
+	case mnemonicString != "":
+		detected, err := bip39.DetectLanguage(bip39.ParseMnemonic(mnemonicString))
+		if err != nil {
+			return fmt.Errorf("failed detecting mnemonic language: %w", err)
+		}
+		return bip39.SetWordList(detected)
+	default:
+		return bip39.SetWordList("english")
+	}
+}
+
+func generateEntropy(size int) ([]byte, error) {
+	entropy := make([]byte, size)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// Legacy IOTA seed derivation as implemented in the blue-app-iota:
+// https://github.com/IOTA-Ledger/blue-app-iota/blob/master/docs/specification.md#iota-seed
+func iotaSeedFromKey(key *slip10.Key) trinary.Hash {
+	// the 512 bits extended private key (k, c) of the provided address path is then hashed using Kerl.
+	hash := kerl.NewKerl()
+
+	// as Kerl expects multiples of 48 bytes as input, the following 96 bytes are absorbed:
+	// k[0:32] + c[0:16] + k[16:32] + c[0:32]
+	var entropy []byte
+	entropy = append(entropy, key.Key[0:32]...)
+	entropy = append(entropy, key.ChainCode[0:16]...)
+	entropy = append(entropy, key.Key[16:32]...)
+	entropy = append(entropy, key.ChainCode[0:32]...)
+
+	// absorb two chunks of 48 bytes
+	in, _ := kerl.KerlBytesToTrytes(entropy[:consts.HashBytesSize])
+	hash.MustAbsorbTrytes(in)
+	in, _ = kerl.KerlBytesToTrytes(entropy[consts.HashBytesSize:])
+	hash.MustAbsorbTrytes(in)
+
+	// derive the the final 243 trit IOTA seed
+	return hash.MustSqueezeTrytes(consts.HashTrinarySize)
+}
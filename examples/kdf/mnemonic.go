@@ -0,0 +1,74 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wollac/iota-crypto-demo/pkg/bip39"
+)
+
+// wordCountToEntropyBits maps the supported BIP-39 mnemonic lengths to the entropy size they
+// encode.
+var wordCountToEntropyBits = map[int]int{
+	12: 128,
+	15: 160,
+	18: 192,
+	21: 224,
+	24: 256,
+}
+
+// runMnemonic implements the "mnemonic" subcommand: it generates a fresh BIP-39 mnemonic of a
+// configurable word count, without deriving any keys from it.
+func runMnemonic(args []string) error {
+	fs := flag.NewFlagSet("mnemonic", flag.ExitOnError)
+	words := fs.Int("words", 24, "number of mnemonic words to generate: 12, 15, 18, 21 or 24")
+	language := fs.String("language", "english", "language of the generated mnemonic")
+	unsafeEntropy := fs.String(
+		"unsafe-entropy",
+		"",
+		"hex-encoded entropy to use instead of a random one, for deterministic generation; UNSAFE for real wallets",
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	bits, ok := wordCountToEntropyBits[*words]
+	if !ok {
+		return fmt.Errorf("unsupported word count %d; expected one of 12, 15, 18, 21, 24", *words)
+	}
+	if err := bip39.SetWordList(*language); err != nil {
+		return err
+	}
+
+	entropySize := bits / 8
+	var entropy []byte
+	if *unsafeEntropy != "" {
+		fmt.Fprintln(os.Stderr, "WARNING: -unsafe-entropy makes the mnemonic only as unpredictable as the supplied value; never use it for a real wallet")
+
+		decoded, err := hex.DecodeString(*unsafeEntropy)
+		if err != nil {
+			return fmt.Errorf("invalid -unsafe-entropy: %w", err)
+		}
+		if len(decoded) < entropySize {
+			return fmt.Errorf("-unsafe-entropy must be at least %d bytes for %d words, got %d", entropySize, *words, len(decoded))
+		}
+		entropy = decoded[:entropySize]
+	} else {
+		entropy = make([]byte, entropySize)
+		if _, err := rand.Read(entropy); err != nil {
+			return fmt.Errorf("failed generating entropy: %w", err)
+		}
+	}
+
+	mnemonic, err := bip39.EntropyToMnemonic(entropy)
+	if err != nil {
+		return fmt.Errorf("failed generating mnemonic: %w", err)
+	}
+
+	fmt.Printf("entropy (%d-byte):\t%x\n", len(entropy), entropy)
+	fmt.Printf("mnemonic (%d-word):\t%s\n", len(mnemonic), mnemonic)
+	return nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/wollac/iota-crypto-demo/pkg/bip39"
+	"github.com/wollac/iota-crypto-demo/pkg/slip39"
+)
+
+// runShamirSplit implements the "shamir-split" subcommand: it derives the BIP-39 seed for a
+// mnemonic and backs it up as a single group of SLIP-39 shares.
+func runShamirSplit(args []string) error {
+	fs := flag.NewFlagSet("shamir-split", flag.ExitOnError)
+	mnemonicString := fs.String(
+		"mnemonic",
+		"",
+		"BIP-39 mnemonic sentence whose seed is backed up as SLIP-39 shares",
+	)
+	language := fs.String(
+		"language",
+		"",
+		"language of -mnemonic; if empty it is auto-detected",
+	)
+	bip39Passphrase := fs.String(
+		"bip39-passphrase",
+		"",
+		"BIP-39 passphrase used to derive the seed; can be empty",
+	)
+	shamirPassphrase := fs.String(
+		"passphrase",
+		"",
+		"SLIP-39 passphrase protecting the shares; must be supplied again to shamir-combine",
+	)
+	shareCount := fs.Int("shares", 5, "number of SLIP-39 shares to generate")
+	threshold := fs.Int("threshold", 3, "number of shares required to recombine the seed")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *mnemonicString == "" {
+		return fmt.Errorf("-mnemonic is required")
+	}
+
+	mnemonic := bip39.ParseMnemonic(*mnemonicString)
+	if err := setWordList(*language, *mnemonicString); err != nil {
+		return err
+	}
+	seed, err := bip39.MnemonicToSeed(mnemonic, *bip39Passphrase)
+	if err != nil {
+		return fmt.Errorf("failed deriving seed: %w", err)
+	}
+
+	groups := []slip39.GroupConfig{{MemberThreshold: *threshold, MemberCount: *shareCount}}
+	split, err := slip39.Split(seed, 1, groups, *shamirPassphrase)
+	if err != nil {
+		return fmt.Errorf("failed splitting seed: %w", err)
+	}
+
+	fmt.Printf("generated %d SLIP-39 shares, %d required to recombine:\n", *shareCount, *threshold)
+	for _, share := range split[0] {
+		words, err := share.Mnemonic()
+		if err != nil {
+			return fmt.Errorf("failed rendering share %d: %w", share.MemberIndex+1, err)
+		}
+		fmt.Printf("  %d: %s\n", share.MemberIndex+1, strings.Join(words, " "))
+	}
+	return nil
+}
+
+// runShamirCombine implements the "shamir-combine" subcommand: it recombines a threshold of
+// SLIP-39 shares produced by shamir-split back into the original BIP-39 seed.
+func runShamirCombine(args []string) error {
+	fs := flag.NewFlagSet("shamir-combine", flag.ExitOnError)
+	var shares shareList
+	fs.Var(&shares, "share", "a SLIP-39 share mnemonic sentence; repeat -share for every share")
+	shamirPassphrase := fs.String(
+		"passphrase",
+		"",
+		"SLIP-39 passphrase the shares were split with; can be empty",
+	)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(shares) == 0 {
+		return fmt.Errorf("at least one -share is required")
+	}
+
+	group := make([]slip39.Share, len(shares))
+	for i, s := range shares {
+		share, err := slip39.ParseShare(strings.Fields(s))
+		if err != nil {
+			return fmt.Errorf("invalid share %d: %w", i+1, err)
+		}
+		group[i] = *share
+	}
+
+	seed, err := slip39.Combine([][]slip39.Share{group}, *shamirPassphrase)
+	if err != nil {
+		return fmt.Errorf("failed combining shares: %w", err)
+	}
+	fmt.Printf("recovered seed (%d bytes): %x\n", len(seed), seed)
+	return nil
+}
+
+// shareList collects repeated -share flag values.
+type shareList []string
+
+func (s *shareList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *shareList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/wollac/iota-crypto-demo/pkg/bip39"
+)
+
+// runVerify implements the "verify" subcommand: it validates a mnemonic's checksum and reports
+// its word count and entropy size, without deriving any keys from it.
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	mnemonicString := fs.String("mnemonic", "", "mnemonic sentence to validate")
+	language := fs.String("language", "", "language of the mnemonic; if empty it is auto-detected")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *mnemonicString == "" {
+		return fmt.Errorf("-mnemonic is required")
+	}
+
+	mnemonic := bip39.ParseMnemonic(*mnemonicString)
+	if err := setWordList(*language, *mnemonicString); err != nil {
+		return err
+	}
+
+	entropy, err := bip39.MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return fmt.Errorf("invalid mnemonic: %w", err)
+	}
+
+	fmt.Printf("valid mnemonic: %d words, %d-bit entropy\n", len(mnemonic), len(entropy)*8)
+	return nil
+}
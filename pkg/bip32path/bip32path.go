@@ -0,0 +1,65 @@
+// Package bip32path parses and renders BIP-32 style hierarchical deterministic derivation paths,
+// e.g. "44'/4218'/0'/0'": https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+package bip32path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hardenedOffset is added to a child index to mark it as hardened, as defined by BIP-32.
+const hardenedOffset = 1 << 31
+
+// Path is a sequence of child indices describing a derivation path. Indices at or above
+// hardenedOffset are hardened.
+type Path []uint32
+
+// String renders the path using the same notation accepted by ParsePath.
+func (p Path) String() string {
+	segments := make([]string, len(p))
+	for i, index := range p {
+		if index >= hardenedOffset {
+			segments[i] = fmt.Sprintf("%d'", index-hardenedOffset)
+		} else {
+			segments[i] = strconv.FormatUint(uint64(index), 10)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// ParsePath parses a derivation path such as "44'/4218'/0'/0'" into its child indices. A
+// leading "m/" is optional, and a trailing ' or h marks a hardened index.
+func ParsePath(s string) (Path, error) {
+	s = strings.TrimPrefix(s, "m/")
+	if s == "" {
+		return Path{}, nil
+	}
+
+	segments := strings.Split(s, "/")
+	path := make(Path, len(segments))
+	for i, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("path segment %d is empty", i)
+		}
+
+		hardened := false
+		if last := segment[len(segment)-1]; last == '\'' || last == 'h' || last == 'H' {
+			hardened = true
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path segment %q: %w", segment, err)
+		}
+		if index >= hardenedOffset {
+			return nil, fmt.Errorf("path segment %q out of range", segment)
+		}
+		if hardened {
+			index += hardenedOffset
+		}
+		path[i] = uint32(index)
+	}
+	return path, nil
+}
@@ -0,0 +1,28 @@
+package bip32path
+
+import "testing"
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("m/44'/4218'/0'/0")
+	if err != nil {
+		t.Fatalf("ParsePath: %v", err)
+	}
+	want := Path{44 + hardenedOffset, 4218 + hardenedOffset, 0 + hardenedOffset, 0}
+	if len(path) != len(want) {
+		t.Fatalf("ParsePath returned %v, want %v", path, want)
+	}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Fatalf("ParsePath returned %v, want %v", path, want)
+		}
+	}
+	if got := path.String(); got != "44'/4218'/0'/0" {
+		t.Errorf("Path.String() = %q, want %q", got, "44'/4218'/0'/0")
+	}
+}
+
+func TestParsePathRejectsEmptySegment(t *testing.T) {
+	if _, err := ParsePath("44'//0'"); err == nil {
+		t.Fatal("ParsePath did not reject an empty path segment")
+	}
+}
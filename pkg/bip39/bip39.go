@@ -0,0 +1,89 @@
+// Package bip39 implements mnemonic code generation for deterministic keys, as defined by BIP-39:
+// https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+package bip39
+
+import (
+	"fmt"
+	"strings"
+)
+
+// bitsPerWord is the number of bits encoded by each word of a mnemonic sentence.
+const bitsPerWord = 11
+
+// Mnemonic is a BIP-39 mnemonic sentence, represented as its individual words.
+type Mnemonic []string
+
+// String joins the words of the mnemonic into a single space-separated sentence.
+func (m Mnemonic) String() string {
+	return strings.Join(m, " ")
+}
+
+// EntropyToMnemonic derives the mnemonic sentence encoding the given entropy and its checksum.
+// The entropy must be 16, 20, 24, 28 or 32 bytes (128 to 256 bits) long. The active word list,
+// as set by SetWordList, is used to map the encoded bits to words.
+func EntropyToMnemonic(entropy []byte) (Mnemonic, error) {
+	bitSize := len(entropy) * 8
+	if bitSize < 128 || bitSize > 256 || bitSize%32 != 0 {
+		return nil, fmt.Errorf("invalid entropy length: %d bits", bitSize)
+	}
+	wl, err := currentWordList()
+	if err != nil {
+		return nil, err
+	}
+
+	checksumBits := bitSize / 32
+	data := appendChecksum(entropy, checksumBits)
+
+	wordCount := (bitSize + checksumBits) / bitsPerWord
+	words := make(Mnemonic, wordCount)
+	for i := range words {
+		idx := extractBits(data, i*bitsPerWord, bitsPerWord)
+		word, err := wl.word(idx)
+		if err != nil {
+			return nil, err
+		}
+		words[i] = word
+	}
+	return words, nil
+}
+
+// ParseMnemonic splits the given sentence into its individual words. It does not validate the
+// checksum or that the words belong to the active word list; use MnemonicToEntropy for that.
+func ParseMnemonic(sentence string) Mnemonic {
+	return Mnemonic(strings.Fields(sentence))
+}
+
+// MnemonicToEntropy recovers the entropy encoded by the mnemonic and validates its checksum. It
+// uses the active word list set via SetWordList, or auto-detects the language from the mnemonic
+// itself if none was set.
+func MnemonicToEntropy(mnemonic Mnemonic) ([]byte, error) {
+	wl, err := wordListFor(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	wordCount := len(mnemonic)
+	if wordCount < 12 || wordCount > 24 || wordCount%3 != 0 {
+		return nil, fmt.Errorf("invalid mnemonic length: %d words", wordCount)
+	}
+
+	totalBits := wordCount * bitsPerWord
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+
+	data := make([]byte, (totalBits+7)/8)
+	for i, word := range mnemonic {
+		idx, ok := wl.index(word)
+		if !ok {
+			return nil, fmt.Errorf("word %q is not part of the %s word list", word, wl.language)
+		}
+		setBits(data, i*bitsPerWord, bitsPerWord, idx)
+	}
+
+	entropy := data[:entropyBits/8]
+	want := appendChecksum(entropy, checksumBits)
+	if extractBits(data, entropyBits, checksumBits) != extractBits(want, entropyBits, checksumBits) {
+		return nil, fmt.Errorf("invalid mnemonic checksum")
+	}
+	return entropy, nil
+}
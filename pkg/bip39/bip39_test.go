@@ -0,0 +1,85 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// vectors are known-answer test vectors for entropy<->mnemonic<->seed conversion, taken from the
+// reference BIP-39 test suite (github.com/trezor/python-mnemonic), using the "TREZOR" passphrase.
+var vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		"00000000000000000000000000000000000000000000000000000000000000"[:32],
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"ffffffffffffffffffffffffffffffff",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		"ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	},
+}
+
+func TestKnownAnswerVectors(t *testing.T) {
+	if err := SetWordList("english"); err != nil {
+		t.Fatalf("SetWordList: %v", err)
+	}
+
+	for _, v := range vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		if err != nil {
+			t.Fatalf("invalid test vector entropy: %v", err)
+		}
+
+		mnemonic, err := EntropyToMnemonic(entropy)
+		if err != nil {
+			t.Fatalf("EntropyToMnemonic(%s): %v", v.entropy, err)
+		}
+		if got := mnemonic.String(); got != v.mnemonic {
+			t.Errorf("EntropyToMnemonic(%s) = %q, want %q", v.entropy, got, v.mnemonic)
+		}
+
+		gotEntropy, err := MnemonicToEntropy(mnemonic)
+		if err != nil {
+			t.Fatalf("MnemonicToEntropy(%s): %v", v.mnemonic, err)
+		}
+		if hex.EncodeToString(gotEntropy) != v.entropy {
+			t.Errorf("MnemonicToEntropy(%s) = %x, want %s", v.mnemonic, gotEntropy, v.entropy)
+		}
+
+		seed, err := MnemonicToSeed(mnemonic, "TREZOR")
+		if err != nil {
+			t.Fatalf("MnemonicToSeed(%s): %v", v.mnemonic, err)
+		}
+		if got := hex.EncodeToString(seed); got != v.seed {
+			t.Errorf("MnemonicToSeed(%s) = %s, want %s", v.mnemonic, got, v.seed)
+		}
+	}
+}
+
+func TestMnemonicToEntropyInvalidChecksum(t *testing.T) {
+	if err := SetWordList("english"); err != nil {
+		t.Fatalf("SetWordList: %v", err)
+	}
+
+	mnemonic := ParseMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	if _, err := MnemonicToEntropy(mnemonic); err == nil {
+		t.Fatal("MnemonicToEntropy did not reject a mnemonic with an invalid checksum")
+	}
+}
+
+func TestMnemonicToSeedWithIterationsRejectsLowCount(t *testing.T) {
+	mnemonic := ParseMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	if _, err := MnemonicToSeedWithIterations(mnemonic, "", StandardSeedIterations-1); err == nil {
+		t.Fatal("MnemonicToSeedWithIterations did not reject an iteration count below the BIP-39 standard")
+	}
+}
@@ -0,0 +1,41 @@
+package bip39
+
+import "crypto/sha256"
+
+// appendChecksum returns entropy followed by the whole first byte of its SHA-256 hash, packed into
+// a single big-endian bit stream; callers read only its leading checksumBits bits as the
+// checksum, so checksumBits must not exceed 8.
+func appendChecksum(entropy []byte, checksumBits int) []byte {
+	hash := sha256.Sum256(entropy)
+	data := make([]byte, len(entropy)+1)
+	copy(data, entropy)
+	data[len(entropy)] = hash[0]
+	return data
+}
+
+// extractBits reads bitLength bits (at most 64) starting at bitOffset from the big-endian bit
+// stream data and returns them as the low bits of the result.
+func extractBits(data []byte, bitOffset, bitLength int) int {
+	value := 0
+	for i := 0; i < bitLength; i++ {
+		byteIdx := (bitOffset + i) / 8
+		bitIdx := 7 - (bitOffset+i)%8
+		bit := (data[byteIdx] >> bitIdx) & 1
+		value = value<<1 | int(bit)
+	}
+	return value
+}
+
+// setBits writes the low bitLength bits of value into the big-endian bit stream data, starting
+// at bitOffset. data must already be zeroed.
+func setBits(data []byte, bitOffset, bitLength, value int) {
+	for i := 0; i < bitLength; i++ {
+		bit := (value >> (bitLength - 1 - i)) & 1
+		if bit == 0 {
+			continue
+		}
+		byteIdx := (bitOffset + i) / 8
+		bitIdx := 7 - (bitOffset+i)%8
+		data[byteIdx] |= 1 << bitIdx
+	}
+}
@@ -0,0 +1,31 @@
+package bip39
+
+import "fmt"
+
+// SupportedLanguages lists the BIP-39 word list languages bundled with this package. Only english
+// is bundled; the other eight official BIP-39 word lists (Chinese Simplified/Traditional, Czech,
+// French, Italian, Japanese, Korean, Portuguese, Spanish) are deliberately not included. Each is a
+// 2048-word constant that must match its canonical upstream source exactly for a wallet to
+// recover funds on another implementation, several in non-Latin scripts; without a way to verify
+// a candidate file against that source, bundling one risks shipping a plausible-looking but wrong
+// list (see TestSupportedLanguagesAreBundled for the consistency check this would fail). A
+// language can be added once its word list has been verified against the reference source: drop
+// the verified "<language>.txt", one word per line, into the wordlists directory and add its name
+// here.
+var SupportedLanguages = []string{"english"}
+
+// DetectLanguage inspects the words of the given mnemonic and returns the name of the bundled
+// word list that contains all of them. It returns an error if no single bundled word list
+// matches, which happens for invalid sentences or ones mixing words from different languages.
+func DetectLanguage(mnemonic Mnemonic) (string, error) {
+	for _, language := range SupportedLanguages {
+		wl, err := loadWordList(language)
+		if err != nil {
+			return "", err
+		}
+		if wl.containsAll(mnemonic) {
+			return language, nil
+		}
+	}
+	return "", fmt.Errorf("mnemonic does not match any bundled word list")
+}
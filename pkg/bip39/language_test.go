@@ -0,0 +1,42 @@
+package bip39
+
+import (
+	"io/fs"
+	"testing"
+)
+
+// TestSupportedLanguagesAreBundled checks that SupportedLanguages and the wordlists directory
+// agree exactly: every supported language has a bundled word list, and every bundled word list is
+// advertised as supported. This catches both ways SupportedLanguages' claim could go stale -- a
+// language listed without its data, or a data file added without registering it.
+func TestSupportedLanguagesAreBundled(t *testing.T) {
+	entries, err := fs.Glob(wordListFS, "wordlists/*.txt")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+
+	bundled := make(map[string]bool, len(entries))
+	for _, path := range entries {
+		bundled[path] = true
+	}
+
+	supported := make(map[string]bool, len(SupportedLanguages))
+	for _, language := range SupportedLanguages {
+		supported[language] = true
+
+		path := "wordlists/" + language + ".txt"
+		if !bundled[path] {
+			t.Errorf("SupportedLanguages lists %q but wordlists/%s.txt is not bundled", language, language)
+		}
+		if _, err := loadWordList(language); err != nil {
+			t.Errorf("loadWordList(%q): %v", language, err)
+		}
+	}
+
+	for path := range bundled {
+		language := path[len("wordlists/") : len(path)-len(".txt")]
+		if !supported[language] {
+			t.Errorf("wordlists/%s.txt is bundled but %q is not listed in SupportedLanguages", language, language)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package bip39
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalizedSeedInput returns the mnemonic sentence and passphrase as required for seed
+// derivation, both normalized to NFKD as mandated by BIP-39. Word lists that use a different word
+// separator, such as the ideographic space U+3000 mandated for Japanese, are not bundled with
+// this package yet (see SupportedLanguages), so a plain space is used here.
+func normalizedSeedInput(mnemonic Mnemonic, passphrase string) (sentence, normalizedPassphrase string) {
+	sentence = norm.NFKD.String(strings.Join(mnemonic, " "))
+	normalizedPassphrase = norm.NFKD.String(passphrase)
+	return sentence, normalizedPassphrase
+}
@@ -0,0 +1,40 @@
+package bip39
+
+import (
+	"crypto/sha512"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// StandardSeedIterations is the PBKDF2-HMAC-SHA512 iteration count mandated by BIP-39 for
+// deriving the master seed from a mnemonic and optional passphrase.
+const StandardSeedIterations = 2048
+
+// seedSize is the length in bytes of a BIP-39 master seed.
+const seedSize = 64
+
+// MnemonicToSeed derives the master seed from the given mnemonic sentence and optional
+// passphrase, using the standard 2048 PBKDF2-HMAC-SHA512 iterations defined by BIP-39.
+func MnemonicToSeed(mnemonic Mnemonic, passphrase string) ([]byte, error) {
+	return MnemonicToSeedWithIterations(mnemonic, passphrase, StandardSeedIterations)
+}
+
+// MnemonicToSeedWithIterations derives the master seed like MnemonicToSeed, but allows
+// overriding the PBKDF2 iteration count to harden the seed against brute-force passphrase
+// guessing on high-value wallets.
+//
+// Raising iterations above StandardSeedIterations trades reproducibility for hardening: the same
+// mnemonic and passphrase only reproduce the same seed when combined with the same iteration
+// count, so a non-standard value must be recorded and supplied again on every later derivation.
+// iterations below StandardSeedIterations fall short of the BIP-39 baseline and are rejected.
+func MnemonicToSeedWithIterations(mnemonic Mnemonic, passphrase string, iterations int) ([]byte, error) {
+	if iterations < StandardSeedIterations {
+		return nil, fmt.Errorf("iteration count %d is below the BIP-39 minimum of %d", iterations, StandardSeedIterations)
+	}
+
+	sentence, normalizedPassphrase := normalizedSeedInput(mnemonic, passphrase)
+
+	salt := "mnemonic" + normalizedPassphrase
+	return pbkdf2.Key([]byte(sentence), []byte(salt), iterations, seedSize, sha512.New), nil
+}
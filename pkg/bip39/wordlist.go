@@ -0,0 +1,108 @@
+package bip39
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+)
+
+//go:embed wordlists/*.txt
+var wordListFS embed.FS
+
+// wordListSize is the fixed size of every BIP-39 word list: 2^bitsPerWord words.
+const wordListSize = 1 << bitsPerWord
+
+// wordList is a loaded and indexed BIP-39 word list for a single language.
+type wordList struct {
+	language string
+	words    []string
+	indices  map[string]int
+}
+
+func (wl *wordList) word(index int) (string, error) {
+	if index < 0 || index >= len(wl.words) {
+		return "", fmt.Errorf("word index %d out of range", index)
+	}
+	return wl.words[index], nil
+}
+
+func (wl *wordList) index(word string) (int, bool) {
+	i, ok := wl.indices[word]
+	return i, ok
+}
+
+// containsAll reports whether every word of the mnemonic is part of this word list.
+func (wl *wordList) containsAll(mnemonic Mnemonic) bool {
+	if len(mnemonic) == 0 {
+		return false
+	}
+	for _, word := range mnemonic {
+		if _, ok := wl.indices[word]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// active is the word list currently selected via SetWordList.
+var active *wordList
+
+// loaded caches word lists that have already been read from wordListFS, keyed by language.
+var loaded = map[string]*wordList{}
+
+// SetWordList loads the word list for the given language and makes it the active word list used
+// by MnemonicToEntropy and EntropyToMnemonic.
+func SetWordList(language string) error {
+	wl, err := loadWordList(language)
+	if err != nil {
+		return err
+	}
+	active = wl
+	return nil
+}
+
+func currentWordList() (*wordList, error) {
+	if active == nil {
+		return nil, fmt.Errorf("no word list set: call SetWordList first")
+	}
+	return active, nil
+}
+
+// wordListFor returns the active word list if one was set via SetWordList, falling back to
+// detecting the language of the given mnemonic otherwise.
+func wordListFor(mnemonic Mnemonic) (*wordList, error) {
+	if active != nil {
+		return active, nil
+	}
+	language, err := DetectLanguage(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("no word list set and language could not be detected: %w", err)
+	}
+	return loadWordList(language)
+}
+
+func loadWordList(language string) (*wordList, error) {
+	language = strings.ToLower(language)
+	if wl, ok := loaded[language]; ok {
+		return wl, nil
+	}
+
+	data, err := wordListFS.ReadFile(fmt.Sprintf("wordlists/%s.txt", language))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported word list language %q: %w", language, err)
+	}
+
+	words := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(words) != wordListSize {
+		return nil, fmt.Errorf("word list for %q must contain %d words, got %d", language, wordListSize, len(words))
+	}
+
+	indices := make(map[string]int, len(words))
+	for i, w := range words {
+		indices[w] = i
+	}
+
+	wl := &wordList{language: language, words: words, indices: indices}
+	loaded[language] = wl
+	return wl, nil
+}
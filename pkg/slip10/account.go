@@ -0,0 +1,48 @@
+package slip10
+
+import "github.com/wollac/iota-crypto-demo/pkg/bip32path"
+
+// AccountXKey is the extended key at a BIP-44 account level, m/purpose'/coinType'/account', from
+// which an entire account's change and address-index keys can be derived without repeating the
+// first three derivation steps: https://github.com/bitcoin/bips/blob/master/bip-0044.mediawiki
+type AccountXKey struct {
+	key   *Key
+	curve Curve
+}
+
+// DeriveAccount derives the BIP-44 account extended key m/purpose'/coinType'/account' for seed
+// under curve. All three levels are hardened, as required for curves such as Ed25519 that only
+// support hardened derivation.
+func DeriveAccount(seed []byte, curve Curve, purpose, coinType, account uint32) (*AccountXKey, error) {
+	path := bip32path.Path{
+		purpose + hardenedOffset,
+		coinType + hardenedOffset,
+		account + hardenedOffset,
+	}
+	key, err := DeriveKeyFromPath(seed, curve, path)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountXKey{key: key, curve: curve}, nil
+}
+
+// Address derives the key at the given change (0 for external, 1 for internal/change addresses)
+// and addressIndex below the account, m/.../change/addressIndex. Ed25519 only supports hardened
+// derivation, so change and addressIndex are derived hardened for it and non-hardened for every
+// other curve.
+func (a *AccountXKey) Address(change, addressIndex uint32) (*Key, error) {
+	offset := uint32(0)
+	if a.curve.Name() == Ed25519().Name() {
+		offset = hardenedOffset
+	}
+
+	key := a.key
+	for _, index := range []uint32{change + offset, addressIndex + offset} {
+		var err error
+		key, err = a.curve.derive(key, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,90 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Radix = big.NewInt(int64(len(base58Alphabet)))
+
+// base58CheckEncode encodes payload as Base58Check: payload followed by the first 4 bytes of the
+// double SHA-256 checksum, with leading zero bytes preserved as leading '1' characters.
+func base58CheckEncode(payload []byte) string {
+	checksum := doubleSHA256(payload)
+	data := append(append([]byte{}, payload...), checksum[:4]...)
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	num := new(big.Int).SetBytes(data)
+	var out []byte
+	mod := new(big.Int)
+	for num.Sign() > 0 {
+		num.DivMod(num, base58Radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		out = append(out, base58Alphabet[0])
+	}
+	reverse(out)
+	return string(out)
+}
+
+// base58CheckDecode decodes a Base58Check string, verifying and stripping its 4-byte checksum.
+func base58CheckDecode(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	num := new(big.Int)
+	for _, c := range s {
+		idx := indexByte(base58Alphabet, byte(c))
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		num.Mul(num, base58Radix)
+		num.Add(num, big.NewInt(int64(idx)))
+	}
+
+	decoded := num.Bytes()
+	data := make([]byte, zeros+len(decoded))
+	copy(data[zeros:], decoded)
+
+	if len(data) < 4 {
+		return nil, fmt.Errorf("base58check payload too short")
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	want := doubleSHA256(payload)
+	for i := range checksum {
+		if checksum[i] != want[i] {
+			return nil, fmt.Errorf("invalid base58check checksum")
+		}
+	}
+	return payload, nil
+}
+
+func doubleSHA256(data []byte) [32]byte {
+	first := sha256.Sum256(data)
+	return sha256.Sum256(first[:])
+}
+
+func indexByte(alphabet string, c byte) int {
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
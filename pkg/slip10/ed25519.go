@@ -0,0 +1,48 @@
+package slip10
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"fmt"
+)
+
+// ed25519Curve implements Curve for Ed25519 as defined by SLIP-10. Ed25519 has no well-defined
+// point addition compatible with this scheme, so only hardened child derivation is supported.
+type ed25519Curve struct{}
+
+// Ed25519 returns the Curve implementation for Ed25519 key derivation.
+func Ed25519() Curve {
+	return ed25519Curve{}
+}
+
+func (ed25519Curve) Name() string {
+	return "ed25519"
+}
+
+func (ed25519Curve) SeedKey() string {
+	return "ed25519 seed"
+}
+
+// PublicKey derives the Ed25519 public key for key, treating key.Key as the Ed25519 private seed.
+func (ed25519Curve) PublicKey(key *Key) []byte {
+	priv := ed25519.NewKeyFromSeed(key.Key[:])
+	return priv.Public().(ed25519.PublicKey)
+}
+
+func (c ed25519Curve) derive(key *Key, index uint32) (*Key, error) {
+	if index < hardenedOffset {
+		return nil, fmt.Errorf("ed25519 only supports hardened derivation, got non-hardened index %d", index)
+	}
+
+	mac := hmac.New(sha512.New, key.ChainCode[:])
+	mac.Write([]byte{0x00})
+	mac.Write(key.Key[:])
+	mac.Write(serializeIndex(index))
+	sum := mac.Sum(nil)
+
+	child := &Key{}
+	copy(child.Key[:], sum[:PrivateKeySize])
+	copy(child.ChainCode[:], sum[PrivateKeySize:])
+	return child, nil
+}
@@ -0,0 +1,123 @@
+package slip10
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // required by BIP-32's fingerprint definition
+)
+
+// Version byte pairs for the standard BIP-32 extended key prefixes. Callers are free to pass any
+// other 4-byte pair to support alternative prefixes such as ypub/zpub.
+var (
+	VersionMainnetPrivate = [4]byte{0x04, 0x88, 0xAD, 0xE4} // xprv
+	VersionMainnetPublic  = [4]byte{0x04, 0x88, 0xB2, 0x1E} // xpub
+	VersionTestnetPrivate = [4]byte{0x04, 0x35, 0x83, 0x94} // tprv
+	VersionTestnetPublic  = [4]byte{0x04, 0x35, 0x87, 0xCF} // tpub
+)
+
+// extendedKeySize is the fixed payload length of a BIP-32 extended key, before Base58Check
+// encoding: 4 (version) + 1 (depth) + 4 (parent fingerprint) + 4 (child number) + 32 (chain code)
+// + 33 (key).
+const extendedKeySize = 78
+
+// SerializePrivate encodes key as a Base58Check extended private key (e.g. xprv/tprv) using the
+// given version bytes, tree depth, parent key fingerprint and child number. Only secp256k1 keys
+// support BIP-32 extended key serialization.
+func (k *Key) SerializePrivate(curve Curve, version [4]byte, depth byte, parentFingerprint [4]byte, childNumber uint32) (string, error) {
+	if curve.Name() != Secp256k1().Name() {
+		return "", fmt.Errorf("extended key serialization is not defined for %s keys", curve.Name())
+	}
+
+	payload := make([]byte, 0, extendedKeySize)
+	payload = append(payload, version[:]...)
+	payload = append(payload, depth)
+	payload = append(payload, parentFingerprint[:]...)
+	payload = append(payload, serializeIndex(childNumber)...)
+	payload = append(payload, k.ChainCode[:]...)
+	payload = append(payload, 0x00)
+	payload = append(payload, k.Key[:]...)
+
+	return base58CheckEncode(payload), nil
+}
+
+// SerializePublic encodes the public key corresponding to key as a Base58Check extended public
+// key (e.g. xpub/tpub). Ed25519 has no defined extended public key format, since SLIP-10
+// restricts it to hardened-only derivation and there is no way to derive child public keys
+// without the private key; such keys are rejected here.
+func (k *Key) SerializePublic(curve Curve, version [4]byte, depth byte, parentFingerprint [4]byte, childNumber uint32) (string, error) {
+	if curve.Name() != Secp256k1().Name() {
+		return "", fmt.Errorf("extended public keys are not defined for %s keys: SLIP-10 only supports hardened derivation for it", curve.Name())
+	}
+
+	payload := make([]byte, 0, extendedKeySize)
+	payload = append(payload, version[:]...)
+	payload = append(payload, depth)
+	payload = append(payload, parentFingerprint[:]...)
+	payload = append(payload, serializeIndex(childNumber)...)
+	payload = append(payload, k.ChainCode[:]...)
+	payload = append(payload, curve.PublicKey(k)...)
+
+	return base58CheckEncode(payload), nil
+}
+
+// Fingerprint computes the BIP-32 key fingerprint of key: the first 4 bytes of the RIPEMD-160
+// hash of the SHA-256 hash of its compressed public key (HASH160). It identifies a key's parent
+// in an extended key's ParentFingerprint field; only the master key may use the all-zero
+// fingerprint.
+func Fingerprint(curve Curve, key *Key) ([4]byte, error) {
+	if curve.Name() != Secp256k1().Name() {
+		return [4]byte{}, fmt.Errorf("key fingerprints are not defined for %s keys", curve.Name())
+	}
+
+	sha := sha256.Sum256(curve.PublicKey(key))
+	ripemd := ripemd160.New()
+	ripemd.Write(sha[:])
+
+	var fingerprint [4]byte
+	copy(fingerprint[:], ripemd.Sum(nil))
+	return fingerprint, nil
+}
+
+// ExtendedKey is the parsed representation of a Base58Check-encoded BIP-32 extended key, as
+// produced by SerializePrivate or SerializePublic.
+type ExtendedKey struct {
+	Version           [4]byte
+	Depth             byte
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [ChainCodeSize]byte
+	// IsPrivate reports whether Key holds a private key (xprv-style) or a compressed public key
+	// (xpub-style).
+	IsPrivate bool
+	Key       []byte
+}
+
+// ParseExtendedKey decodes a Base58Check-encoded extended key produced by SerializePrivate or
+// SerializePublic, for any version bytes (xprv/xpub, tprv/tpub, ypub, zpub, ...).
+func ParseExtendedKey(s string) (*ExtendedKey, error) {
+	payload, err := base58CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != extendedKeySize {
+		return nil, fmt.Errorf("invalid extended key length: %d bytes, expected %d", len(payload), extendedKeySize)
+	}
+
+	ek := &ExtendedKey{Depth: payload[4]}
+	copy(ek.Version[:], payload[:4])
+	copy(ek.ParentFingerprint[:], payload[5:9])
+	ek.ChildNumber = binary.BigEndian.Uint32(payload[9:13])
+	copy(ek.ChainCode[:], payload[13:45])
+
+	keyData := payload[45:extendedKeySize]
+	if keyData[0] == 0x00 {
+		ek.IsPrivate = true
+		ek.Key = append([]byte{}, keyData[1:]...)
+	} else {
+		ek.IsPrivate = false
+		ek.Key = append([]byte{}, keyData...)
+	}
+	return ek, nil
+}
@@ -0,0 +1,173 @@
+package slip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+)
+
+// secp256k1Curve implements Curve for secp256k1 as defined by SLIP-10/BIP-32. Unlike Ed25519, it
+// supports both hardened and normal (public) child derivation.
+type secp256k1Curve struct{}
+
+// Secp256k1 returns the Curve implementation for secp256k1 key derivation, as used by Bitcoin and
+// the legacy IOTA Ledger app.
+func Secp256k1() Curve {
+	return secp256k1Curve{}
+}
+
+func (secp256k1Curve) Name() string {
+	return "secp256k1"
+}
+
+func (secp256k1Curve) SeedKey() string {
+	return "Bitcoin seed"
+}
+
+// PublicKey derives the 33-byte compressed secp256k1 public key for key.
+func (secp256k1Curve) PublicKey(key *Key) []byte {
+	x, y := secp256k1Params.scalarBaseMult(new(big.Int).SetBytes(key.Key[:]))
+	return compressPoint(x, y)
+}
+
+func (c secp256k1Curve) derive(key *Key, index uint32) (*Key, error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0x00}, key.Key[:]...)
+	} else {
+		data = c.PublicKey(key)
+	}
+	data = append(data, serializeIndex(index)...)
+
+	mac := hmac.New(sha512.New, key.ChainCode[:])
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	il := new(big.Int).SetBytes(sum[:PrivateKeySize])
+	if il.Cmp(secp256k1Params.n) >= 0 {
+		return nil, fmt.Errorf("derived key is invalid, IL is out of range")
+	}
+
+	childScalar := new(big.Int).Add(il, new(big.Int).SetBytes(key.Key[:]))
+	childScalar.Mod(childScalar, secp256k1Params.n)
+	if childScalar.Sign() == 0 {
+		return nil, fmt.Errorf("derived key is invalid, resulting scalar is zero")
+	}
+
+	child := &Key{}
+	childScalar.FillBytes(child.Key[:])
+	copy(child.ChainCode[:], sum[PrivateKeySize:])
+	return child, nil
+}
+
+// compressPoint encodes the point (x, y) in 33-byte compressed SEC1 form.
+func compressPoint(x, y *big.Int) []byte {
+	out := make([]byte, 33)
+	if y.Bit(0) == 0 {
+		out[0] = 0x02
+	} else {
+		out[0] = 0x03
+	}
+	x.FillBytes(out[1:])
+	return out
+}
+
+// curveParams holds the secp256k1 domain parameters and the short Weierstrass arithmetic needed
+// to compute public keys from private scalars.
+type curveParams struct {
+	p, n, gx, gy *big.Int
+}
+
+func (c *curveParams) scalarBaseMult(k *big.Int) (x, y *big.Int) {
+	return c.scalarMult(c.gx, c.gy, k)
+}
+
+// scalarMult computes k*(x, y) using the textbook double-and-add algorithm over affine
+// coordinates. It is not constant time and is only used here for public key derivation.
+func (c *curveParams) scalarMult(x, y *big.Int, k *big.Int) (rx, ry *big.Int) {
+	rx, ry = nil, nil
+	px, py := new(big.Int).Set(x), new(big.Int).Set(y)
+
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		rx, ry = c.double(rx, ry)
+		if k.Bit(i) == 1 {
+			rx, ry = c.add(rx, ry, px, py)
+		}
+	}
+	return rx, ry
+}
+
+func (c *curveParams) add(x1, y1, x2, y2 *big.Int) (x3, y3 *big.Int) {
+	if x1 == nil {
+		return new(big.Int).Set(x2), new(big.Int).Set(y2)
+	}
+	if x2 == nil {
+		return new(big.Int).Set(x1), new(big.Int).Set(y1)
+	}
+	if x1.Cmp(x2) == 0 {
+		if y1.Cmp(y2) != 0 {
+			return nil, nil // point at infinity
+		}
+		return c.double(x1, y1)
+	}
+
+	// lambda = (y2 - y1) / (x2 - x1) mod p
+	lambda := new(big.Int).Sub(y2, y1)
+	denom := new(big.Int).Sub(x2, x1)
+	denom.ModInverse(denom, c.p)
+	lambda.Mul(lambda, denom)
+	lambda.Mod(lambda, c.p)
+
+	x3 = new(big.Int).Mul(lambda, lambda)
+	x3.Sub(x3, x1)
+	x3.Sub(x3, x2)
+	x3.Mod(x3, c.p)
+
+	y3 = new(big.Int).Sub(x1, x3)
+	y3.Mul(y3, lambda)
+	y3.Sub(y3, y1)
+	y3.Mod(y3, c.p)
+
+	return x3, y3
+}
+
+func (c *curveParams) double(x1, y1 *big.Int) (x3, y3 *big.Int) {
+	if x1 == nil || y1.Sign() == 0 {
+		return nil, nil
+	}
+
+	// lambda = 3*x1^2 / (2*y1) mod p (a = 0 for secp256k1)
+	lambda := new(big.Int).Mul(x1, x1)
+	lambda.Mul(lambda, big.NewInt(3))
+	denom := new(big.Int).Lsh(y1, 1)
+	denom.ModInverse(denom, c.p)
+	lambda.Mul(lambda, denom)
+	lambda.Mod(lambda, c.p)
+
+	x3 = new(big.Int).Mul(lambda, lambda)
+	x3.Sub(x3, new(big.Int).Lsh(x1, 1))
+	x3.Mod(x3, c.p)
+
+	y3 = new(big.Int).Sub(x1, x3)
+	y3.Mul(y3, lambda)
+	y3.Sub(y3, y1)
+	y3.Mod(y3, c.p)
+
+	return x3, y3
+}
+
+var secp256k1Params = &curveParams{
+	p:  mustHex("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F"),
+	n:  mustHex("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141"),
+	gx: mustHex("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798"),
+	gy: mustHex("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8"),
+}
+
+func mustHex(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("slip10: invalid secp256k1 constant " + s)
+	}
+	return v
+}
@@ -0,0 +1,70 @@
+// Package slip10 implements hierarchical deterministic key derivation for multiple elliptic
+// curves, as defined by SLIP-0010: https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+package slip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"fmt"
+
+	"github.com/wollac/iota-crypto-demo/pkg/bip32path"
+)
+
+const (
+	// PrivateKeySize is the size in bytes of a derived private key.
+	PrivateKeySize = 32
+	// ChainCodeSize is the size in bytes of a chain code.
+	ChainCodeSize = 32
+	// PublicKeySize is the size in bytes of an Ed25519 public key. Secp256k1 public keys are
+	// serialized in compressed SEC1 form and are one byte larger.
+	PublicKeySize = 32
+)
+
+// Key is a derived extended private key: a raw key together with its chain code.
+type Key struct {
+	Key       [PrivateKeySize]byte
+	ChainCode [ChainCodeSize]byte
+}
+
+// Curve abstracts over the elliptic curves supported for SLIP-10 derivation.
+type Curve interface {
+	// Name returns the human readable name of the curve.
+	Name() string
+	// SeedKey returns the HMAC-SHA512 key used to derive the master key from a seed, as defined
+	// by SLIP-10.
+	SeedKey() string
+	// PublicKey derives the public key corresponding to key.
+	PublicKey(key *Key) []byte
+	// derive computes the child key at the given index.
+	derive(key *Key, index uint32) (*Key, error)
+}
+
+// DeriveKeyFromPath derives the extended private key reached by walking path from the master key
+// of seed on the given curve.
+func DeriveKeyFromPath(seed []byte, curve Curve, path bip32path.Path) (*Key, error) {
+	key, err := masterKey(seed, curve)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range path {
+		key, err = curve.derive(key, index)
+		if err != nil {
+			return nil, fmt.Errorf("failed deriving child %d: %w", index, err)
+		}
+	}
+	return key, nil
+}
+
+// masterKey derives the master extended private key of seed for the given curve.
+func masterKey(seed []byte, curve Curve) (*Key, error) {
+	mac := hmac.New(sha512.New, []byte(curve.SeedKey()))
+	if _, err := mac.Write(seed); err != nil {
+		return nil, err
+	}
+	sum := mac.Sum(nil)
+
+	key := &Key{}
+	copy(key.Key[:], sum[:PrivateKeySize])
+	copy(key.ChainCode[:], sum[PrivateKeySize:])
+	return key, nil
+}
@@ -0,0 +1,82 @@
+package slip10
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/wollac/iota-crypto-demo/pkg/bip32path"
+)
+
+// vectors are known-answer test vectors for master and hardened child key derivation, following
+// SLIP-10's "Test vector 1" seed (also used by BIP-32): "000102030405060708090a0b0c0d0e0f".
+var vectors = []struct {
+	curve        Curve
+	seed         string
+	masterKey    string
+	masterChain  string
+	child0hKey   string
+	child0hChain string
+}{
+	{
+		Secp256k1(),
+		"000102030405060708090a0b0c0d0e0f",
+		"e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+		"edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141",
+	},
+	{
+		Ed25519(),
+		"000102030405060708090a0b0c0d0e0f",
+		"2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+		"68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+	},
+}
+
+func TestKnownAnswerVectors(t *testing.T) {
+	for _, v := range vectors {
+		seed, err := hex.DecodeString(v.seed)
+		if err != nil {
+			t.Fatalf("invalid test vector seed: %v", err)
+		}
+
+		master, err := DeriveKeyFromPath(seed, v.curve, bip32path.Path{})
+		if err != nil {
+			t.Fatalf("%s: DeriveKeyFromPath(master): %v", v.curve.Name(), err)
+		}
+		if got := hex.EncodeToString(master.Key[:]); got != v.masterKey {
+			t.Errorf("%s: master key = %s, want %s", v.curve.Name(), got, v.masterKey)
+		}
+		if got := hex.EncodeToString(master.ChainCode[:]); got != v.masterChain {
+			t.Errorf("%s: master chain code = %s, want %s", v.curve.Name(), got, v.masterChain)
+		}
+
+		path, err := bip32path.ParsePath("0'")
+		if err != nil {
+			t.Fatalf("ParsePath: %v", err)
+		}
+		child, err := DeriveKeyFromPath(seed, v.curve, path)
+		if err != nil {
+			t.Fatalf("%s: DeriveKeyFromPath(0'): %v", v.curve.Name(), err)
+		}
+		if got := hex.EncodeToString(child.Key[:]); got != v.child0hKey {
+			t.Errorf("%s: child 0' key = %s, want %s", v.curve.Name(), got, v.child0hKey)
+		}
+		if got := hex.EncodeToString(child.ChainCode[:]); got != v.child0hChain {
+			t.Errorf("%s: child 0' chain code = %s, want %s", v.curve.Name(), got, v.child0hChain)
+		}
+	}
+}
+
+func TestEd25519RejectsNonHardenedDerivation(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	path, err := bip32path.ParsePath("0")
+	if err != nil {
+		t.Fatalf("ParsePath: %v", err)
+	}
+	if _, err := DeriveKeyFromPath(seed, Ed25519(), path); err == nil {
+		t.Fatal("DeriveKeyFromPath did not reject non-hardened derivation for Ed25519")
+	}
+}
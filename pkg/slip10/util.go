@@ -0,0 +1,13 @@
+package slip10
+
+import "encoding/binary"
+
+// hardenedOffset is added to a child index to mark it as hardened, mirroring bip32path.
+const hardenedOffset = 1 << 31
+
+// serializeIndex encodes a child index as a big-endian ser32, as defined by SLIP-10/BIP-32.
+func serializeIndex(index uint32) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, index)
+	return buf
+}
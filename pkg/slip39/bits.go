@@ -0,0 +1,65 @@
+package slip39
+
+// bitWriter accumulates values of arbitrary bit width into a big-endian bit stream.
+type bitWriter struct {
+	bits []byte // one bit per slice element, MSB-first overall
+}
+
+func (w *bitWriter) writeBits(value uint32, width int) {
+	for i := width - 1; i >= 0; i-- {
+		w.bits = append(w.bits, byte((value>>uint(i))&1))
+	}
+}
+
+// bytes packs the accumulated bits into bytes, zero-padding the final byte on the right.
+func (w *bitWriter) bytes() []byte {
+	out := make([]byte, (len(w.bits)+7)/8)
+	for i, bit := range w.bits {
+		if bit == 1 {
+			out[i/8] |= 1 << uint(7-i%8)
+		}
+	}
+	return out
+}
+
+// bitReader reads values of arbitrary bit width from a big-endian bit stream, indexed by word
+// (bitsPerWord-wide chunk) to match how SLIP-39 shares are packed.
+type bitReader struct {
+	data      []byte
+	offset    int // bit offset
+	totalBits int // number of valid bits in data, excluding the trailing byte-alignment padding
+}
+
+func newBitReaderFromWords(wordIndices []uint32) *bitReader {
+	w := &bitWriter{}
+	for _, idx := range wordIndices {
+		w.writeBits(idx, bitsPerWord)
+	}
+	return &bitReader{data: w.bytes(), totalBits: len(wordIndices) * bitsPerWord}
+}
+
+// remainingBits returns the number of valid bits left to read, not counting the trailing
+// zero bits bitWriter.bytes added to round the word data up to a whole number of bytes.
+func (r *bitReader) remainingBits() int {
+	return r.totalBits - r.offset
+}
+
+func (r *bitReader) readBits(width int) uint32 {
+	var value uint32
+	for i := 0; i < width; i++ {
+		byteIdx := (r.offset + i) / 8
+		bitIdx := 7 - (r.offset+i)%8
+		bit := (r.data[byteIdx] >> uint(bitIdx)) & 1
+		value = value<<1 | uint32(bit)
+	}
+	r.offset += width
+	return value
+}
+
+func (r *bitReader) readBytes(n int) []byte {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = byte(r.readBits(8))
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+package slip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// feistelRounds is the number of Feistel rounds used to encrypt the master secret, as defined by
+// SLIP-39.
+const feistelRounds = 4
+
+// baseIterationCount is the PBKDF2 iteration count for an iteration exponent of 0; the effective
+// count doubles with every increment of the exponent.
+const baseIterationCount = 10000
+
+// encryptMasterSecret encrypts secret (the Shamir master secret) with passphrase using a
+// 4-round Feistel network keyed by PBKDF2-HMAC-SHA256, as defined by SLIP-39. identifier and
+// iterationExponent are mixed into the key derivation, so the same values must be supplied again
+// on decryption.
+func encryptMasterSecret(secret, passphrase []byte, identifier uint16, iterationExponent byte) []byte {
+	l := append([]byte{}, secret[:len(secret)/2]...)
+	r := append([]byte{}, secret[len(secret)/2:]...)
+	salt := feistelSalt(identifier)
+
+	for round := 0; round < feistelRounds; round++ {
+		l, r = r, xorBytes(l, roundFunction(byte(round), passphrase, iterationExponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+// decryptMasterSecret reverses encryptMasterSecret.
+func decryptMasterSecret(encrypted, passphrase []byte, identifier uint16, iterationExponent byte) []byte {
+	l := append([]byte{}, encrypted[:len(encrypted)/2]...)
+	r := append([]byte{}, encrypted[len(encrypted)/2:]...)
+	salt := feistelSalt(identifier)
+
+	for round := feistelRounds - 1; round >= 0; round-- {
+		l, r = r, xorBytes(l, roundFunction(byte(round), passphrase, iterationExponent, salt, r))
+	}
+	return append(r, l...)
+}
+
+func roundFunction(round byte, passphrase []byte, iterationExponent byte, salt, r []byte) []byte {
+	password := append([]byte{round}, passphrase...)
+	s := append(append([]byte{}, salt...), r...)
+	iterations := (baseIterationCount << iterationExponent) / feistelRounds
+	return pbkdf2.Key(password, s, iterations, len(r), sha256.New)
+}
+
+// feistelSalt derives the PBKDF2 salt prefix from the share identifier, binding the encryption to
+// this particular secret sharing.
+func feistelSalt(identifier uint16) []byte {
+	salt := []byte("shamir")
+	id := make([]byte, 2)
+	binary.BigEndian.PutUint16(id, identifier)
+	return append(salt, id...)
+}
+
+func xorBytes(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package slip39
+
+// gf256 implements arithmetic in GF(2^8) with the AES reduction polynomial x^8+x^4+x^3+x+1
+// (0x11B), which SLIP-39 uses for its Shamir secret sharing scheme.
+var (
+	gf256Exp [255]byte
+	gf256Log [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gf256Exp[i] = x
+		gf256Log[x] = byte(i)
+		x = gf256Mul(x, 3)
+	}
+}
+
+// gf256Mul multiplies a and b in GF(2^8) using the Russian peasant algorithm with reduction.
+func gf256Mul(a, b byte) byte {
+	var p byte
+	for b != 0 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		hi := a & 0x80
+		a <<= 1
+		if hi != 0 {
+			a ^= 0x1B
+		}
+		b >>= 1
+	}
+	return p
+}
+
+func gf256MulLog(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	sum := int(gf256Log[a]) + int(gf256Log[b])
+	return gf256Exp[sum%255]
+}
+
+func gf256Div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	if b == 0 {
+		panic("slip39: division by zero in GF(256)")
+	}
+	diff := (int(gf256Log[a]) - int(gf256Log[b]) + 255) % 255
+	return gf256Exp[diff]
+}
+
+// interpolateByteAt evaluates, at x, the unique polynomial of degree len(xs)-1 passing through
+// the given (x, y) sample points, using Lagrange interpolation.
+func interpolateByteAt(xs, ys []byte, x byte) byte {
+	var result byte
+	for i := range xs {
+		var num, den byte = 1, 1
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			num = gf256MulLog(num, x^xs[j])
+			den = gf256MulLog(den, xs[i]^xs[j])
+		}
+		result ^= gf256MulLog(ys[i], gf256Div(num, den))
+	}
+	return result
+}
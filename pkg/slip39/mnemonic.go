@@ -0,0 +1,100 @@
+package slip39
+
+import "fmt"
+
+// headerBits is the total width of a share's metadata header, before its value and checksum:
+// identifier (15) + iteration exponent (4) + group index (4) + group threshold (4) +
+// group count (4) + member index (4) + member threshold (4).
+const headerBits = 15 + 4 + 4 + 4 + 4 + 4 + 4
+
+// Mnemonic renders the share as its SLIP-39 word sequence: a header encoding the share's
+// metadata, followed by its value and a trailing RS1024 checksum.
+func (s *Share) Mnemonic() ([]string, error) {
+	w := &bitWriter{}
+	w.writeBits(uint32(s.Identifier), 15)
+	w.writeBits(uint32(s.IterationExponent), 4)
+	w.writeBits(uint32(s.GroupIndex), 4)
+	w.writeBits(uint32(s.GroupThreshold-1), 4)
+	w.writeBits(uint32(s.GroupCount-1), 4)
+	w.writeBits(uint32(s.MemberIndex), 4)
+	w.writeBits(uint32(s.MemberThreshold-1), 4)
+	for _, b := range s.Value {
+		w.writeBits(uint32(b), 8)
+	}
+	for len(w.bits)%bitsPerWord != 0 {
+		w.bits = append(w.bits, 0)
+	}
+
+	dataWords := wordsFromBits(w.bits)
+	checksum := rs1024CreateChecksum(dataWords)
+	allWords := append(dataWords, checksum[:]...)
+
+	mnemonic := make([]string, len(allWords))
+	for i, idx := range allWords {
+		word, err := wordAt(int(idx))
+		if err != nil {
+			return nil, err
+		}
+		mnemonic[i] = word
+	}
+	return mnemonic, nil
+}
+
+// ParseShare parses a SLIP-39 mnemonic word sequence into a Share, verifying its checksum.
+func ParseShare(mnemonic []string) (*Share, error) {
+	if len(mnemonic)*bitsPerWord < headerBits+checksumWords*bitsPerWord {
+		return nil, fmt.Errorf("mnemonic has too few words to be a valid share")
+	}
+
+	dataWords := make([]uint32, len(mnemonic))
+	for i, word := range mnemonic {
+		idx, ok := indexOf(word)
+		if !ok {
+			return nil, fmt.Errorf("word %q is not part of the SLIP-39 word list", word)
+		}
+		dataWords[i] = uint32(idx)
+	}
+	if !rs1024VerifyChecksum(dataWords) {
+		return nil, fmt.Errorf("invalid share checksum")
+	}
+	dataWords = dataWords[:len(dataWords)-checksumWords]
+
+	r := newBitReaderFromWords(dataWords)
+	share := &Share{
+		Identifier:        uint16(r.readBits(15)),
+		IterationExponent: byte(r.readBits(4)),
+		GroupIndex:        byte(r.readBits(4)),
+		GroupThreshold:    byte(r.readBits(4)) + 1,
+		GroupCount:        byte(r.readBits(4)) + 1,
+		MemberIndex:       byte(r.readBits(4)),
+		MemberThreshold:   byte(r.readBits(4)) + 1,
+	}
+	share.Value = r.readBytes(valueByteLength(r.remainingBits()))
+	return share, nil
+}
+
+// valueByteLength recovers the number of whole value bytes from the number of bits remaining
+// after the header, undoing the zero padding Mnemonic adds to align the share to a whole number
+// of words. The padding is always fewer than 10 bits, so at most two byte counts are consistent
+// with remainingBits; Split only produces values of even length, so the even one is taken.
+func valueByteLength(remainingBits int) int {
+	l := remainingBits / 8
+	if l%2 != 0 && remainingBits-8*(l-1) < bitsPerWord {
+		return l - 1
+	}
+	return l
+}
+
+// wordsFromBits packs a bit slice (already padded to a multiple of bitsPerWord) into word
+// indices.
+func wordsFromBits(bits []byte) []uint32 {
+	words := make([]uint32, len(bits)/bitsPerWord)
+	for i := range words {
+		var v uint32
+		for j := 0; j < bitsPerWord; j++ {
+			v = v<<1 | uint32(bits[i*bitsPerWord+j])
+		}
+		words[i] = v
+	}
+	return words
+}
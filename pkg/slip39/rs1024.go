@@ -0,0 +1,49 @@
+package slip39
+
+// checksumWords is the number of trailing words (30 bits) reserved for the RS1024 checksum.
+const checksumWords = 3
+
+// customizationString is mixed into every RS1024 checksum to bind it to the SLIP-39 share
+// format, so a checksum computed for one purpose cannot be reused for another.
+var customizationString = []uint32{'s', 'h', 'a', 'm', 'i', 'r'}
+
+// rs1024Gen are the generator constants of the RS1024 BCH-like checksum, operating on 10-bit
+// (word-sized) symbols.
+var rs1024Gen = [10]uint32{
+	0xE0E040, 0xCE0FF8, 0xFD4813, 0xFFDA13, 0xFFB803,
+	0xFF8014, 0xFF0019, 0xFB0055, 0xE800AD, 0xE98012,
+}
+
+func rs1024Polymod(values []uint32) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xFFFFF)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if (b>>uint(i))&1 != 0 {
+				chk ^= rs1024Gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// rs1024CreateChecksum returns the 3 checksum words for the given data words.
+func rs1024CreateChecksum(data []uint32) [checksumWords]uint32 {
+	values := append(append([]uint32{}, customizationString...), data...)
+	values = append(values, 0, 0, 0)
+	polymod := rs1024Polymod(values) ^ 1
+
+	var checksum [checksumWords]uint32
+	for i := 0; i < checksumWords; i++ {
+		checksum[i] = (polymod >> uint(10*(checksumWords-1-i))) & 1023
+	}
+	return checksum
+}
+
+// rs1024VerifyChecksum reports whether the trailing checksumWords of data form a valid RS1024
+// checksum for the preceding words.
+func rs1024VerifyChecksum(data []uint32) bool {
+	values := append(append([]uint32{}, customizationString...), data...)
+	return rs1024Polymod(values) == 1
+}
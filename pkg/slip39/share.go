@@ -0,0 +1,282 @@
+// Package slip39 implements SLIP-0039 Shamir's Secret-Sharing for Mnemonic Codes, allowing a
+// master secret to be split into mnemonic shares that require a threshold number to recombine:
+// https://github.com/satoshilabs/slips/blob/master/slip-0039.md
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+)
+
+// defaultIterationExponent controls the PBKDF2 cost of the master secret encryption; see
+// baseIterationCount.
+const defaultIterationExponent = 1
+
+// digestIndex and secretIndex are the two reserved x-coordinates SLIP-39 uses, besides a share's
+// own index, to embed a verifiable digest of the shared secret into the sharing polynomial: see
+// splitSecretBytes and recoverSecretBytes.
+const (
+	digestIndex       = 254
+	secretIndex       = 255
+	digestLengthBytes = 4
+)
+
+// GroupConfig describes one group of a SLIP-39 split: how many member shares to generate for the
+// group, and how many of them are required to reconstruct that group's secret.
+type GroupConfig struct {
+	MemberThreshold int
+	MemberCount     int
+}
+
+// Share is a single SLIP-39 share: a fragment of a two-level Shamir secret split, together with
+// the metadata needed to recombine it with the other shares.
+type Share struct {
+	Identifier        uint16
+	IterationExponent byte
+	GroupIndex        byte
+	GroupThreshold    byte
+	GroupCount        byte
+	MemberIndex       byte
+	MemberThreshold   byte
+	Value             []byte
+}
+
+// Split splits masterSecret into SLIP-39 shares arranged in groups: groupThreshold of the groups
+// are required to recombine, and within each of those groups, that group's MemberThreshold out of
+// MemberCount member shares are required. passphrase may be empty; the same passphrase must be
+// supplied again to Combine. masterSecret must be at least 16 bytes and an even length.
+func Split(masterSecret []byte, groupThreshold int, groups []GroupConfig, passphrase string) ([][]Share, error) {
+	if len(masterSecret) < 16 || len(masterSecret)%2 != 0 {
+		return nil, fmt.Errorf("master secret must have an even length of at least 16 bytes, got %d", len(masterSecret))
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("at least one group is required")
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) {
+		return nil, fmt.Errorf("group threshold %d is out of range for %d groups", groupThreshold, len(groups))
+	}
+	for i, g := range groups {
+		if g.MemberThreshold < 1 || g.MemberThreshold > g.MemberCount {
+			return nil, fmt.Errorf("group %d: member threshold %d is out of range for %d members", i, g.MemberThreshold, g.MemberCount)
+		}
+	}
+
+	identifier, err := randomIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	encrypted := encryptMasterSecret(masterSecret, []byte(passphrase), identifier, defaultIterationExponent)
+
+	groupShares, err := splitSecretBytes(encrypted, groupThreshold, len(groups))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]Share, len(groups))
+	for gi, g := range groups {
+		memberShares, err := splitSecretBytes(groupShares[gi], g.MemberThreshold, g.MemberCount)
+		if err != nil {
+			return nil, err
+		}
+
+		shares := make([]Share, g.MemberCount)
+		for mi := range shares {
+			shares[mi] = Share{
+				Identifier:        identifier,
+				IterationExponent: defaultIterationExponent,
+				GroupIndex:        byte(gi),
+				GroupThreshold:    byte(groupThreshold),
+				GroupCount:        byte(len(groups)),
+				MemberIndex:       byte(mi),
+				MemberThreshold:   byte(g.MemberThreshold),
+				Value:             memberShares[mi],
+			}
+		}
+		result[gi] = shares
+	}
+	return result, nil
+}
+
+// Combine reconstructs the master secret from shares: one outer slice per group, each holding
+// that group's collected member shares. At least groupThreshold groups must be present, and each
+// of those groups must hold at least its MemberThreshold of member shares.
+func Combine(shares [][]Share, passphrase string) ([]byte, error) {
+	first, ok := firstShare(shares)
+	if !ok {
+		return nil, fmt.Errorf("no shares given")
+	}
+	identifier := first.Identifier
+	groupThreshold := int(first.GroupThreshold)
+
+	groupXs := make([]byte, 0, len(shares))
+	groupYs := make([][]byte, 0, len(shares))
+	seenGroups := map[byte]bool{}
+
+	for _, group := range shares {
+		if len(group) == 0 {
+			continue
+		}
+		groupIndex := group[0].GroupIndex
+		memberThreshold := int(group[0].MemberThreshold)
+		if len(group) < memberThreshold {
+			return nil, fmt.Errorf("group %d: need %d member shares, got %d", groupIndex, memberThreshold, len(group))
+		}
+
+		memberXs := make([]byte, 0, len(group))
+		memberYs := make([][]byte, 0, len(group))
+		for _, s := range group {
+			if s.Identifier != identifier || s.GroupThreshold != first.GroupThreshold || s.GroupCount != first.GroupCount {
+				return nil, fmt.Errorf("share does not belong to the same split as the others")
+			}
+			if s.GroupIndex != groupIndex {
+				return nil, fmt.Errorf("mixed group indices within a single group's shares")
+			}
+			memberXs = append(memberXs, s.MemberIndex)
+			memberYs = append(memberYs, s.Value)
+		}
+		if err := requireDistinctIndices(memberXs); err != nil {
+			return nil, fmt.Errorf("group %d: %w", groupIndex, err)
+		}
+
+		if seenGroups[groupIndex] {
+			continue
+		}
+		seenGroups[groupIndex] = true
+		groupSecret, err := recoverSecretBytes(memberXs[:memberThreshold], memberYs[:memberThreshold], memberThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %w", groupIndex, err)
+		}
+		groupXs = append(groupXs, groupIndex)
+		groupYs = append(groupYs, groupSecret)
+	}
+
+	if len(groupXs) < groupThreshold {
+		return nil, fmt.Errorf("need shares from %d groups, got %d", groupThreshold, len(groupXs))
+	}
+	if err := requireDistinctIndices(groupXs); err != nil {
+		return nil, err
+	}
+
+	encrypted, err := recoverSecretBytes(groupXs[:groupThreshold], groupYs[:groupThreshold], groupThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return decryptMasterSecret(encrypted, []byte(passphrase), identifier, first.IterationExponent), nil
+}
+
+// requireDistinctIndices returns an error if xs contains a repeated share index; combining two
+// shares with the same index would otherwise divide by zero during Lagrange interpolation.
+func requireDistinctIndices(xs []byte) error {
+	seen := make(map[byte]bool, len(xs))
+	for _, x := range xs {
+		if seen[x] {
+			return fmt.Errorf("duplicate share index %d", x)
+		}
+		seen[x] = true
+	}
+	return nil
+}
+
+func firstShare(shares [][]Share) (Share, bool) {
+	for _, group := range shares {
+		if len(group) > 0 {
+			return group[0], true
+		}
+	}
+	return Share{}, false
+}
+
+func randomIdentifier() (uint16, error) {
+	buf := make([]byte, 2)
+	if _, err := rand.Read(buf); err != nil {
+		return 0, err
+	}
+	return (uint16(buf[0])<<8 | uint16(buf[1])) & 0x7FFF, nil // identifier is 15 bits
+}
+
+// splitSecretBytes splits secret into shareCount shares requiring threshold of them to recombine,
+// following SLIP-39's digest-share scheme. For threshold 1 every share is simply a copy of the
+// secret. Otherwise, the degree-(threshold-1) sharing polynomial is pinned not only by
+// threshold-2 random shares but also by two fixed points: the secret itself at x=secretIndex, and
+// a digest share at x=digestIndex combining an HMAC-SHA256 digest of the secret with random
+// padding. recoverSecretBytes recomputes that digest to detect a wrong or inconsistent
+// combination of shares.
+func splitSecretBytes(secret []byte, threshold, shareCount int) ([][]byte, error) {
+	shares := make([][]byte, shareCount)
+	if threshold == 1 {
+		for i := range shares {
+			shares[i] = append([]byte{}, secret...)
+		}
+		return shares, nil
+	}
+
+	randomShareCount := threshold - 2
+	basePoints := make([]byte, 0, threshold)
+	baseValues := make([][]byte, 0, threshold)
+
+	for i := 0; i < randomShareCount; i++ {
+		v := make([]byte, len(secret))
+		if _, err := rand.Read(v); err != nil {
+			return nil, err
+		}
+		shares[i] = v
+		basePoints = append(basePoints, byte(i))
+		baseValues = append(baseValues, v)
+	}
+
+	randomPart := make([]byte, len(secret)-digestLengthBytes)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+	digestShare := append(createDigest(randomPart, secret), randomPart...)
+
+	basePoints = append(basePoints, digestIndex, secretIndex)
+	baseValues = append(baseValues, digestShare, secret)
+
+	for i := randomShareCount; i < shareCount; i++ {
+		shares[i] = interpolateSecretBytesAt(basePoints, baseValues, byte(i))
+	}
+	return shares, nil
+}
+
+// recoverSecretBytes reconstructs the secret that threshold of the given (x, y) sample points
+// were split from, verifying its embedded digest share when threshold > 1 to detect a wrong or
+// mismatched combination of shares.
+func recoverSecretBytes(xs []byte, ys [][]byte, threshold int) ([]byte, error) {
+	if threshold == 1 {
+		return append([]byte{}, ys[0]...), nil
+	}
+
+	secret := interpolateSecretBytesAt(xs, ys, secretIndex)
+	digestShare := interpolateSecretBytesAt(xs, ys, digestIndex)
+	digest, randomPart := digestShare[:digestLengthBytes], digestShare[digestLengthBytes:]
+	if !hmac.Equal(digest, createDigest(randomPart, secret)) {
+		return nil, fmt.Errorf("invalid digest: shares do not combine to a valid secret")
+	}
+	return secret, nil
+}
+
+// createDigest computes the HMAC-SHA256 digest SLIP-39 embeds alongside randomPart to let
+// recoverSecretBytes detect an inconsistent combination of shares.
+func createDigest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLengthBytes]
+}
+
+// interpolateSecretBytesAt evaluates, at x, the polynomial defined by the given (x, y) sample
+// points, one GF(256) interpolation per byte.
+func interpolateSecretBytesAt(xs []byte, ys [][]byte, x byte) []byte {
+	length := len(ys[0])
+	out := make([]byte, length)
+	column := make([]byte, len(ys))
+	for byteIdx := 0; byteIdx < length; byteIdx++ {
+		for i, y := range ys {
+			column[i] = y[byteIdx]
+		}
+		out[byteIdx] = interpolateByteAt(xs, column, x)
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package slip39
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// These are self-consistency regression tests exercising this package's own Split/Mnemonic/
+// ParseShare/Combine pipeline: they are not official SLIP-39 test vectors (SatoshiLabs publishes
+// those against the canonical word list, which wordlists/english.txt is not yet verified to
+// match byte-for-byte; see the doc comment on wordListData).
+func TestSplitCombineRoundTrip(t *testing.T) {
+	secret, err := hex.DecodeString("4242424242424242424242424242fe42")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cfg := range []struct{ threshold, count int }{{1, 1}, {1, 3}, {2, 3}, {3, 5}, {5, 5}} {
+		t.Run("", func(t *testing.T) {
+			shares := splitIntoShares(t, secret, cfg.threshold, cfg.count)
+
+			recovered, err := Combine([][]Share{shares}, "pw")
+			if err != nil {
+				t.Fatalf("Combine: %v", err)
+			}
+			if hex.EncodeToString(recovered) != hex.EncodeToString(secret) {
+				t.Fatalf("recovered %x, want %x", recovered, secret)
+			}
+
+			if cfg.threshold > 1 {
+				corrupted := append([]Share{}, shares...)
+				v := append([]byte{}, corrupted[0].Value...)
+				v[0] ^= 0xFF
+				corrupted[0].Value = v
+				if _, err := Combine([][]Share{corrupted}, "pw"); err == nil {
+					t.Fatal("Combine did not detect a corrupted share value")
+				}
+
+				dup := append([]Share{}, shares[:cfg.threshold-1]...)
+				dup = append(dup, shares[0])
+				if _, err := Combine([][]Share{dup}, "pw"); err == nil {
+					t.Fatal("Combine did not reject a duplicate share index")
+				}
+			}
+		})
+	}
+}
+
+// splitIntoShares splits secret into a single group of count shares needing threshold of them,
+// round-tripping each through its mnemonic form the way a real user would exchange them.
+func splitIntoShares(t *testing.T, secret []byte, threshold, count int) []Share {
+	t.Helper()
+
+	groups, err := Split(secret, 1, []GroupConfig{{MemberThreshold: threshold, MemberCount: count}}, "pw")
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+
+	shares := make([]Share, threshold)
+	for i := 0; i < threshold; i++ {
+		words, err := groups[0][i].Mnemonic()
+		if err != nil {
+			t.Fatalf("Mnemonic: %v", err)
+		}
+		parsed, err := ParseShare(words)
+		if err != nil {
+			t.Fatalf("ParseShare: %v", err)
+		}
+		shares[i] = *parsed
+	}
+	return shares
+}
@@ -0,0 +1,57 @@
+package slip39
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+)
+
+// wordlists/english.txt holds the 1024-word SLIP-39 word list, distinct from the 2048-word
+// BIP-39 list in pkg/bip39: every word is at least 4 letters and no two words share their first
+// 4 letters, so a share can be reconstructed from 4-letter prefixes alone. Diff it against the
+// canonical list published alongside the SLIP-0039 reference implementation before relying on
+// shares for cross-implementation recovery (e.g. with a hardware wallet); TestWordListInvariants
+// only checks the structural properties above, not word-for-word fidelity to the upstream list.
+//
+//go:embed wordlists/english.txt
+var wordListData embed.FS
+
+// bitsPerWord is the number of bits encoded by each word of a SLIP-39 share, i.e. log2 of the
+// word list size.
+const bitsPerWord = 10
+
+// wordListSize is the fixed size of the SLIP-39 word list: 2^bitsPerWord words.
+const wordListSize = 1 << bitsPerWord
+
+var (
+	words   [wordListSize]string
+	indices = make(map[string]int, wordListSize)
+)
+
+func init() {
+	data, err := wordListData.ReadFile("wordlists/english.txt")
+	if err != nil {
+		panic(fmt.Sprintf("slip39: failed reading bundled word list: %s", err))
+	}
+
+	entries := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(entries) != wordListSize {
+		panic(fmt.Sprintf("slip39: word list must contain %d words, got %d", wordListSize, len(entries)))
+	}
+	for i, w := range entries {
+		words[i] = w
+		indices[w] = i
+	}
+}
+
+func wordAt(index int) (string, error) {
+	if index < 0 || index >= wordListSize {
+		return "", fmt.Errorf("word index %d out of range", index)
+	}
+	return words[index], nil
+}
+
+func indexOf(word string) (int, bool) {
+	i, ok := indices[word]
+	return i, ok
+}
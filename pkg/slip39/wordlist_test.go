@@ -0,0 +1,35 @@
+package slip39
+
+import "testing"
+
+// TestWordListInvariants checks the structural properties the bundled SLIP-39 word list must
+// satisfy: it guards against the specific regression this package once shipped, where
+// wordlists/english.txt was accidentally the first 1024 entries of the bip39 word list rather
+// than SLIP-39's own list.
+func TestWordListInvariants(t *testing.T) {
+	if len(words) != wordListSize {
+		t.Fatalf("got %d words, want %d", len(words), wordListSize)
+	}
+
+	seenWord := make(map[string]bool, wordListSize)
+	seenPrefix := make(map[string]bool, wordListSize)
+	for i, w := range words {
+		if len(w) < 4 {
+			t.Errorf("word %d (%q) is shorter than 4 letters", i, w)
+		}
+		if seenWord[w] {
+			t.Errorf("word %d (%q) is a duplicate", i, w)
+		}
+		seenWord[w] = true
+
+		prefix := w[:min(4, len(w))]
+		if seenPrefix[prefix] {
+			t.Errorf("word %d (%q) shares its first 4 letters with an earlier word", i, w)
+		}
+		seenPrefix[prefix] = true
+
+		if i > 0 && words[i-1] >= w {
+			t.Errorf("word list is not sorted: %q before %q", words[i-1], w)
+		}
+	}
+}